@@ -0,0 +1,98 @@
+package teamcity
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// Authenticator applies credentials to an outgoing request. Implementations
+// must be safe for concurrent use, since a single Authenticator is shared by
+// the retrying transport across retries.
+type Authenticator interface {
+	// Apply sets whatever headers are needed to authenticate req.
+	Apply(req *http.Request) error
+
+	// basePath returns the REST API path prefix this authenticator requires,
+	// relative to the server address (e.g. "/httpAuth/app/rest/").
+	basePath() string
+}
+
+// BasicAuth authenticates with a TeamCity username and password via HTTP
+// basic auth, under the /httpAuth/ prefix.
+type BasicAuth struct {
+	User     string
+	Password string
+}
+
+// Apply implements Authenticator.
+func (a BasicAuth) Apply(req *http.Request) error {
+	req.SetBasicAuth(a.User, a.Password)
+	return nil
+}
+
+func (a BasicAuth) basePath() string {
+	return "/httpAuth/app/rest/"
+}
+
+// BearerToken authenticates with a static personal access token or OAuth2
+// access token, under the unauthenticated-prefix /app/rest/ path.
+type BearerToken struct {
+	Token string
+}
+
+// Apply implements Authenticator.
+func (a BearerToken) Apply(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+func (a BearerToken) basePath() string {
+	return "/app/rest/"
+}
+
+// TokenSource authenticates with a refreshing oauth2.TokenSource, re-fetching
+// the token on every request so it stays valid across retries.
+type TokenSource struct {
+	oauth2.TokenSource
+}
+
+// Apply implements Authenticator.
+func (a TokenSource) Apply(req *http.Request) error {
+	token, err := a.Token()
+	if err != nil {
+		return fmt.Errorf("teamcity: failed to obtain token: %s", err)
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
+
+func (a TokenSource) basePath() string {
+	return "/app/rest/"
+}
+
+// authTransport applies an Authenticator to every outgoing request before
+// delegating to next.
+type authTransport struct {
+	next http.RoundTripper
+	auth Authenticator
+}
+
+func newAuthTransport(next http.RoundTripper, auth Authenticator) *authTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &authTransport{next: next, auth: auth}
+}
+
+// RoundTrip implements http.RoundTripper. It clones the request before
+// mutating headers, per the http.RoundTripper contract, so auth refreshes
+// survive retries performed by an outer retryTransport.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cloned := req.Clone(req.Context())
+	if err := t.auth.Apply(cloned); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(cloned)
+}
@@ -0,0 +1,162 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+)
+
+// BuildType represents a TeamCity build configuration.
+type BuildType struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// BuildTypePage is one page of a BuildType listing, along with the link
+// needed to fetch the next one.
+type BuildTypePage struct {
+	Items []BuildType
+
+	nextHref string
+	service  *BuildTypeService
+}
+
+// HasNext reports whether a further page is available.
+func (p *BuildTypePage) HasNext() bool {
+	return p.nextHref != ""
+}
+
+// Next fetches the next page. It must not be called when HasNext is false.
+func (p *BuildTypePage) Next() (*BuildTypePage, error) {
+	return p.NextContext(p.service.context())
+}
+
+// NextContext is the context-aware variant of Next.
+func (p *BuildTypePage) NextContext(ctx context.Context) (*BuildTypePage, error) {
+	var out struct {
+		BuildType []BuildType `json:"buildType"`
+		NextHref  string      `json:"nextHref"`
+	}
+	if err := decodeHref(ctx, p.service.httpClient, p.service.address, p.nextHref, &out); err != nil {
+		return nil, err
+	}
+	return &BuildTypePage{Items: out.BuildType, nextHref: out.NextHref, service: p.service}, nil
+}
+
+// BuildTypeService manages TeamCity build configurations.
+type BuildTypeService struct {
+	base       *sling.Sling
+	httpClient *http.Client
+	address    string
+	ctx        context.Context
+}
+
+func newBuildTypeService(base *sling.Sling, httpClient *http.Client, address string) *BuildTypeService {
+	return &BuildTypeService{base: base.Path("buildTypes/"), httpClient: httpClient, address: address}
+}
+
+// withContext returns a shallow copy of s whose non-Context methods default
+// to ctx instead of context.Background(). Client.WithContext uses this to
+// propagate its context down to c.BuildTypes.
+func (s *BuildTypeService) withContext(ctx context.Context) *BuildTypeService {
+	clone := *s
+	clone.ctx = ctx
+	return &clone
+}
+
+func (s *BuildTypeService) context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// GetByID returns the build configuration with the given id.
+func (s *BuildTypeService) GetByID(id string) (*BuildType, error) {
+	return s.GetByIDContext(s.context(), id)
+}
+
+// GetByIDContext is the context-aware variant of GetByID.
+func (s *BuildTypeService) GetByIDContext(ctx context.Context, id string) (*BuildType, error) {
+	var out BuildType
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(LocatorID(id)), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// List returns every build configuration matching locator (nil matches
+// all). For large installations, prefer ListPage to avoid buffering the
+// entire result set in memory.
+func (s *BuildTypeService) List(locator *Locator) ([]BuildType, error) {
+	return s.ListContext(s.context(), locator)
+}
+
+// ListContext is the context-aware variant of List.
+func (s *BuildTypeService) ListContext(ctx context.Context, locator *Locator) ([]BuildType, error) {
+	page, err := s.ListPageContext(ctx, locator)
+	if err != nil {
+		return nil, err
+	}
+
+	items := page.Items
+	for page.HasNext() {
+		if page, err = page.NextContext(ctx); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+	}
+	return items, nil
+}
+
+// ListPage returns the first page of build configurations matching locator
+// (nil matches all), following TeamCity's nextHref link on subsequent calls
+// to BuildTypePage.Next so large installations don't need to be buffered in memory.
+func (s *BuildTypeService) ListPage(locator *Locator) (*BuildTypePage, error) {
+	return s.ListPageContext(s.context(), locator)
+}
+
+// ListPageContext is the context-aware variant of ListPage.
+func (s *BuildTypeService) ListPageContext(ctx context.Context, locator *Locator) (*BuildTypePage, error) {
+	path := ""
+	if locator.String() != "" {
+		path = "?locator=" + url.QueryEscape(locator.String())
+	}
+
+	var out struct {
+		BuildType []BuildType `json:"buildType"`
+		NextHref  string      `json:"nextHref"`
+	}
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(path), &out); err != nil {
+		return nil, err
+	}
+	return &BuildTypePage{Items: out.BuildType, nextHref: out.NextHref, service: s}, nil
+}
+
+// Create creates a new build configuration from the given definition.
+func (s *BuildTypeService) Create(buildType *BuildType) (*BuildType, error) {
+	return s.CreateContext(s.context(), buildType)
+}
+
+// CreateContext is the context-aware variant of Create.
+func (s *BuildTypeService) CreateContext(ctx context.Context, buildType *BuildType) (*BuildType, error) {
+	var out BuildType
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Post("").BodyJSON(buildType), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes the build configuration with the given id.
+func (s *BuildTypeService) Delete(id string) error {
+	return s.DeleteContext(s.context(), id)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *BuildTypeService) DeleteContext(ctx context.Context, id string) error {
+	_, err := doJSON(ctx, s.httpClient, s.base.New().Delete(LocatorID(id)), nil)
+	return err
+}
@@ -0,0 +1,83 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// SnapshotDependency represents a snapshot dependency attached to a build
+// configuration.
+type SnapshotDependency struct {
+	ID                string            `json:"id,omitempty"`
+	SourceBuildTypeID string            `json:"-"`
+	Properties        map[string]string `json:"properties,omitempty"`
+}
+
+// DependencyService manages snapshot dependencies for a single build
+// configuration.
+type DependencyService struct {
+	buildTypeID string
+	base        *sling.Sling
+	httpClient  *http.Client
+	ctx         context.Context
+}
+
+// NewDependencyService returns a service to manage snapshot dependencies for
+// the build configuration with the given id.
+func NewDependencyService(buildTypeID string, httpClient *http.Client, base *sling.Sling) *DependencyService {
+	return &DependencyService{
+		buildTypeID: buildTypeID,
+		base:        base.Path("buildTypes/" + LocatorID(buildTypeID) + "/snapshot-dependencies/"),
+		httpClient:  httpClient,
+	}
+}
+
+func (s *DependencyService) context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// List returns all snapshot dependencies configured for the build configuration.
+func (s *DependencyService) List() ([]SnapshotDependency, error) {
+	return s.ListContext(s.context())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *DependencyService) ListContext(ctx context.Context) ([]SnapshotDependency, error) {
+	var out struct {
+		SnapshotDependency []SnapshotDependency `json:"snapshot-dependency"`
+	}
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(""), &out); err != nil {
+		return nil, err
+	}
+	return out.SnapshotDependency, nil
+}
+
+// Add creates a new snapshot dependency on the build configuration.
+func (s *DependencyService) Add(dependency *SnapshotDependency) (*SnapshotDependency, error) {
+	return s.AddContext(s.context(), dependency)
+}
+
+// AddContext is the context-aware variant of Add.
+func (s *DependencyService) AddContext(ctx context.Context, dependency *SnapshotDependency) (*SnapshotDependency, error) {
+	var out SnapshotDependency
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Post("").BodyJSON(dependency), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes the snapshot dependency with the given id.
+func (s *DependencyService) Delete(id string) error {
+	return s.DeleteContext(s.context(), id)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *DependencyService) DeleteContext(ctx context.Context, id string) error {
+	_, err := doJSON(ctx, s.httpClient, s.base.New().Delete(id), nil)
+	return err
+}
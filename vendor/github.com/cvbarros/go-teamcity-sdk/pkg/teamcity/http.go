@@ -0,0 +1,42 @@
+package teamcity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// doJSON builds the request described by sl, executes it against httpClient
+// with ctx attached via req.WithContext, and decodes a successful JSON
+// response into successV (nil to discard the body). It is the shared
+// low-level call used by every service's Context method, mirroring the
+// pattern Client.ValidateContext established for the commonBase itself.
+func doJSON(ctx context.Context, httpClient *http.Client, sl *sling.Sling, successV interface{}) (*http.Response, error) {
+	req, err := sl.Request()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		if readErr != nil {
+			return resp, readErr
+		}
+		return resp, fmt.Errorf("teamcity: API error %s: %s", resp.Status, body)
+	}
+
+	if successV == nil {
+		return resp, nil
+	}
+	return resp, json.NewDecoder(resp.Body).Decode(successV)
+}
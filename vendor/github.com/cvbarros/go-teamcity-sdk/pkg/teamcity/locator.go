@@ -0,0 +1,173 @@
+package teamcity
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Locator builds TeamCity locator strings, the comma-separated
+// "dimension:value" query TeamCity's REST API accepts for filtering list
+// endpoints, e.g.:
+//
+//	teamcity.NewLocator().Project("Foo").AffectedProject("Bar").Count(100).Start(200).String()
+//	// => "project:(id:Foo),affectedProject:(id:Bar),count:100,start:200"
+//
+// The zero value is an empty locator, as is the one NewLocator returns. A nil
+// *Locator is treated by List methods as "no filter" (i.e. all items).
+// ProjectService.List, BuildTypeService.List and VcsRootService.List all
+// accept a *Locator, and their ListPage variants follow TeamCity's nextHref
+// link so large installations don't need to be buffered in memory.
+type Locator struct {
+	dimensions []string
+}
+
+// NewLocator creates an empty Locator ready to be extended with its typed
+// dimension methods or the Add escape hatch.
+func NewLocator() *Locator {
+	return &Locator{}
+}
+
+// LocatorID renders a single-dimension "id:<id>" locator, the form TeamCity
+// accepts in place of a raw id in single-entity path segments such as
+// "projects/id:Foo".
+func LocatorID(id string) string {
+	return "id:" + id
+}
+
+// Add sets an arbitrary dimension, escaping value per TeamCity's locator
+// grammar. Use this for dimensions not covered by a typed method.
+func (l *Locator) Add(key, value string) *Locator {
+	l.dimensions = append(l.dimensions, key+":"+escapeLocatorValue(value))
+	return l
+}
+
+// addNested sets a dimension whose value is itself a nested "(id:...)"
+// locator. The inner value is escaped on its own and wrapped in literal
+// parens; it must not be routed back through Add, which would escape the
+// parens addNested just added.
+func (l *Locator) addNested(key, value string) *Locator {
+	l.dimensions = append(l.dimensions, key+":(id:"+escapeLocatorValue(value)+")")
+	return l
+}
+
+// Project filters by the project with the given id.
+func (l *Locator) Project(id string) *Locator {
+	return l.addNested("project", id)
+}
+
+// AffectedProject filters for items affecting the project with the given id,
+// i.e. including items inherited from parent projects.
+func (l *Locator) AffectedProject(id string) *Locator {
+	return l.addNested("affectedProject", id)
+}
+
+// BuildType filters by the build configuration with the given id.
+func (l *Locator) BuildType(id string) *Locator {
+	return l.addNested("buildType", id)
+}
+
+// Template filters by the build configuration template with the given id.
+func (l *Locator) Template(id string) *Locator {
+	return l.addNested("template", id)
+}
+
+// VcsRoot filters by the VCS root with the given id.
+func (l *Locator) VcsRoot(id string) *Locator {
+	return l.addNested("vcsRoot", id)
+}
+
+// Agent filters by the build agent with the given name.
+func (l *Locator) Agent(name string) *Locator {
+	l.dimensions = append(l.dimensions, "agent:(name:"+escapeLocatorValue(name)+")")
+	return l
+}
+
+// User filters by the username that triggered/owns the item.
+func (l *Locator) User(username string) *Locator {
+	l.dimensions = append(l.dimensions, "user:(username:"+escapeLocatorValue(username)+")")
+	return l
+}
+
+// Tag filters for items carrying the given tag.
+func (l *Locator) Tag(tag string) *Locator {
+	return l.Add("tag", tag)
+}
+
+// Status filters by build status, e.g. "SUCCESS" or "FAILURE".
+func (l *Locator) Status(status string) *Locator {
+	return l.Add("status", status)
+}
+
+// Branch filters by VCS branch name.
+func (l *Locator) Branch(branch string) *Locator {
+	return l.Add("branch", branch)
+}
+
+// Personal filters for personal (true) or non-personal (false) builds.
+func (l *Locator) Personal(personal bool) *Locator {
+	return l.Add("personal", strconv.FormatBool(personal))
+}
+
+// Running filters for currently running (true) or finished (false) builds.
+func (l *Locator) Running(running bool) *Locator {
+	return l.Add("running", strconv.FormatBool(running))
+}
+
+// Canceled filters for canceled (true) or non-canceled (false) builds.
+func (l *Locator) Canceled(canceled bool) *Locator {
+	return l.Add("canceled", strconv.FormatBool(canceled))
+}
+
+// Pinned filters for pinned (true) or unpinned (false) builds.
+func (l *Locator) Pinned(pinned bool) *Locator {
+	return l.Add("pinned", strconv.FormatBool(pinned))
+}
+
+// SinceBuild filters for items after the build with the given id.
+func (l *Locator) SinceBuild(buildID string) *Locator {
+	return l.addNested("sinceBuild", buildID)
+}
+
+// SinceDate filters for items after the given TeamCity-formatted date
+// (yyyyMMdd'T'HHmmssZ).
+func (l *Locator) SinceDate(date string) *Locator {
+	return l.Add("sinceDate", date)
+}
+
+// Property filters for items carrying a configuration parameter with the
+// given name and value.
+func (l *Locator) Property(name, value string) *Locator {
+	l.dimensions = append(l.dimensions, "property:(name:"+escapeLocatorValue(name)+",value:"+escapeLocatorValue(value)+")")
+	return l
+}
+
+// Count limits the number of items returned.
+func (l *Locator) Count(count int) *Locator {
+	return l.Add("count", strconv.Itoa(count))
+}
+
+// Start sets the zero-based index of the first item to return.
+func (l *Locator) Start(start int) *Locator {
+	return l.Add("start", strconv.Itoa(start))
+}
+
+// String renders the locator as a comma-separated dimension list, or "" if
+// no dimensions were set.
+func (l *Locator) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(l.dimensions, ",")
+}
+
+// escapeLocatorValue escapes '$', '(', ')' and ',' per TeamCity's locator
+// grammar, where '$' is the escape character.
+func escapeLocatorValue(value string) string {
+	replacer := strings.NewReplacer(
+		"$", "$$",
+		"(", "$(",
+		")", "$)",
+		",", "$,",
+	)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,50 @@
+package teamcity
+
+import "testing"
+
+func TestLocatorString(t *testing.T) {
+	cases := []struct {
+		name string
+		l    *Locator
+		want string
+	}{
+		{
+			name: "nested and flat dimensions",
+			l:    NewLocator().Project("Foo").AffectedProject("Bar").Count(100).Start(200),
+			want: "project:(id:Foo),affectedProject:(id:Bar),count:100,start:200",
+		},
+		{
+			name: "property dimension",
+			l:    NewLocator().Property("env", "prod"),
+			want: "property:(name:env,value:prod)",
+		},
+		{
+			name: "escapes parens and commas in nested values",
+			l:    NewLocator().Project("Foo (test), v2"),
+			want: "project:(id:Foo $(test$)$, v2)",
+		},
+		{
+			name: "Add escape hatch escapes its value",
+			l:    NewLocator().Add("tag", "a,b"),
+			want: "tag:a$,b",
+		},
+		{
+			name: "nil locator renders empty",
+			l:    nil,
+			want: "",
+		},
+		{
+			name: "empty locator renders empty",
+			l:    NewLocator(),
+			want: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.l.String(); got != c.want {
+				t.Errorf("String() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
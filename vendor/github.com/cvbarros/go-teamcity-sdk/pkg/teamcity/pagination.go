@@ -0,0 +1,37 @@
+package teamcity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// decodeHref performs a raw GET against address+href (the absolute form of
+// the "nextHref" links TeamCity embeds in list responses) and decodes the
+// JSON body into out. It is the shared primitive ListPage iterators use to
+// follow pagination without going back through sling, since nextHref is
+// already a fully-formed, TeamCity-escaped query string.
+func decodeHref(ctx context.Context, httpClient *http.Client, address, href string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, address+href, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		if readErr != nil {
+			return readErr
+		}
+		return fmt.Errorf("teamcity: API error %s: %s", resp.Status, body)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
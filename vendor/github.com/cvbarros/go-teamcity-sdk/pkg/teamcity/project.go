@@ -0,0 +1,163 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+)
+
+// Project represents a TeamCity project.
+type Project struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ParentID    string `json:"parentProjectId,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ProjectPage is one page of a Project listing, along with the link needed
+// to fetch the next one.
+type ProjectPage struct {
+	Items []Project
+
+	nextHref string
+	service  *ProjectService
+}
+
+// HasNext reports whether a further page is available.
+func (p *ProjectPage) HasNext() bool {
+	return p.nextHref != ""
+}
+
+// Next fetches the next page. It must not be called when HasNext is false.
+func (p *ProjectPage) Next() (*ProjectPage, error) {
+	return p.NextContext(p.service.context())
+}
+
+// NextContext is the context-aware variant of Next.
+func (p *ProjectPage) NextContext(ctx context.Context) (*ProjectPage, error) {
+	var out struct {
+		Project  []Project `json:"project"`
+		NextHref string    `json:"nextHref"`
+	}
+	if err := decodeHref(ctx, p.service.httpClient, p.service.address, p.nextHref, &out); err != nil {
+		return nil, err
+	}
+	return &ProjectPage{Items: out.Project, nextHref: out.NextHref, service: p.service}, nil
+}
+
+// ProjectService manages TeamCity projects.
+type ProjectService struct {
+	base       *sling.Sling
+	httpClient *http.Client
+	address    string
+	ctx        context.Context
+}
+
+func newProjectService(base *sling.Sling, httpClient *http.Client, address string) *ProjectService {
+	return &ProjectService{base: base.Path("projects/"), httpClient: httpClient, address: address}
+}
+
+// withContext returns a shallow copy of s whose non-Context methods default
+// to ctx instead of context.Background(). Client.WithContext uses this to
+// propagate its context down to c.Projects.
+func (s *ProjectService) withContext(ctx context.Context) *ProjectService {
+	clone := *s
+	clone.ctx = ctx
+	return &clone
+}
+
+func (s *ProjectService) context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// GetByID returns the project with the given id.
+func (s *ProjectService) GetByID(id string) (*Project, error) {
+	return s.GetByIDContext(s.context(), id)
+}
+
+// GetByIDContext is the context-aware variant of GetByID.
+func (s *ProjectService) GetByIDContext(ctx context.Context, id string) (*Project, error) {
+	var out Project
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(LocatorID(id)), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// List returns every project matching locator (nil matches all projects).
+// For large installations, prefer ListPage to avoid buffering the entire
+// result set in memory.
+func (s *ProjectService) List(locator *Locator) ([]Project, error) {
+	return s.ListContext(s.context(), locator)
+}
+
+// ListContext is the context-aware variant of List.
+func (s *ProjectService) ListContext(ctx context.Context, locator *Locator) ([]Project, error) {
+	page, err := s.ListPageContext(ctx, locator)
+	if err != nil {
+		return nil, err
+	}
+
+	items := page.Items
+	for page.HasNext() {
+		if page, err = page.NextContext(ctx); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+	}
+	return items, nil
+}
+
+// ListPage returns the first page of projects matching locator (nil matches
+// all projects), following TeamCity's nextHref link on subsequent calls to
+// ProjectPage.Next so large installations don't need to be buffered in memory.
+func (s *ProjectService) ListPage(locator *Locator) (*ProjectPage, error) {
+	return s.ListPageContext(s.context(), locator)
+}
+
+// ListPageContext is the context-aware variant of ListPage.
+func (s *ProjectService) ListPageContext(ctx context.Context, locator *Locator) (*ProjectPage, error) {
+	path := ""
+	if locator.String() != "" {
+		path = "?locator=" + url.QueryEscape(locator.String())
+	}
+
+	var out struct {
+		Project  []Project `json:"project"`
+		NextHref string    `json:"nextHref"`
+	}
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(path), &out); err != nil {
+		return nil, err
+	}
+	return &ProjectPage{Items: out.Project, nextHref: out.NextHref, service: s}, nil
+}
+
+// Create creates a new project from the given definition.
+func (s *ProjectService) Create(project *Project) (*Project, error) {
+	return s.CreateContext(s.context(), project)
+}
+
+// CreateContext is the context-aware variant of Create.
+func (s *ProjectService) CreateContext(ctx context.Context, project *Project) (*Project, error) {
+	var out Project
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Post("").BodyJSON(project), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes the project with the given id.
+func (s *ProjectService) Delete(id string) error {
+	return s.DeleteContext(s.context(), id)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *ProjectService) DeleteContext(ctx context.Context, id string) error {
+	_, err := doJSON(ctx, s.httpClient, s.base.New().Delete(LocatorID(id)), nil)
+	return err
+}
@@ -0,0 +1,63 @@
+package teamcity
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dghubble/sling"
+)
+
+func newTestProjectService(t *testing.T, handler http.HandlerFunc) (*ProjectService, *httptest.Server) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	base := sling.New().Base(server.URL + "/app/rest/")
+	return newProjectService(base, server.Client(), server.URL), server
+}
+
+func TestProjectServiceListSendsLocator(t *testing.T) {
+	var gotQuery string
+	service, server := newTestProjectService(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"project":[{"id":"Foo"}]}`))
+	})
+	defer server.Close()
+
+	projects, err := service.List(NewLocator().AffectedProject("Bar").Count(1))
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(projects) != 1 || projects[0].ID != "Foo" {
+		t.Fatalf("List() = %+v, want one project with id Foo", projects)
+	}
+	if !strings.Contains(gotQuery, "affectedProject") {
+		t.Fatalf("request query = %q, want it to carry the locator", gotQuery)
+	}
+}
+
+func TestProjectServiceListFollowsNextHref(t *testing.T) {
+	calls := 0
+	service, server := newTestProjectService(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Write([]byte(`{"project":[{"id":"Foo"}],"nextHref":"/app/rest/projects?start:1"}`))
+			return
+		}
+		w.Write([]byte(`{"project":[{"id":"Bar"}]}`))
+	})
+	defer server.Close()
+
+	projects, err := service.List(nil)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("server received %d requests, want 2 (initial page + nextHref)", calls)
+	}
+	if len(projects) != 2 || projects[0].ID != "Foo" || projects[1].ID != "Bar" {
+		t.Fatalf("List() = %+v, want [Foo Bar]", projects)
+	}
+}
@@ -0,0 +1,208 @@
+package teamcity
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the retrying transport backs off and which
+// requests are considered retryable.
+type RetryPolicy struct {
+	// MaxAttempts caps the number of attempts, including the first one. Zero
+	// means no limit other than the owning Client's RetryTimeout.
+	MaxAttempts int
+
+	// InitialInterval is the backoff delay before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the backoff delay between retries.
+	MaxInterval time.Duration
+
+	// Multiplier is applied to the backoff delay after every attempt.
+	Multiplier float64
+
+	// Jitter is the fraction (0..1) of random jitter applied to each delay.
+	Jitter float64
+
+	// ShouldRetry decides if a response/error pair should be retried. When
+	// nil, defaultShouldRetry is used.
+	ShouldRetry func(resp *http.Response, err error) bool
+
+	// OnRetry, when set, is called before every retry attempt with the
+	// 1-based attempt number that just failed and the error that triggered
+	// it (nil if the attempt failed with a retryable status code instead).
+	OnRetry func(attempt int, err error)
+}
+
+// DefaultRetryPolicy is used when a Client enables RetryTimeout without
+// supplying a custom RetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     30 * time.Second,
+	Multiplier:      2,
+	Jitter:          0.1,
+}
+
+// defaultShouldRetry retries 5xx responses, 429s, and net.Error timeouts or
+// connection resets. It gives up on every other 4xx and on non-network
+// errors such as context cancellation.
+func defaultShouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return true
+		}
+		return isConnectionReset(err)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// isConnectionReset reports whether err is (or wraps) a transient
+// connection-reset failure, which net.Error.Timeout() does not cover since
+// a reset is reported as a non-timeout error.
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts == 0 && p.InitialInterval == 0 && p.MaxInterval == 0 && p.Multiplier == 0 && p.Jitter == 0 {
+		p.Jitter = DefaultRetryPolicy.Jitter
+	}
+	if p.InitialInterval <= 0 {
+		p.InitialInterval = DefaultRetryPolicy.InitialInterval
+	}
+	if p.MaxInterval <= 0 {
+		p.MaxInterval = DefaultRetryPolicy.MaxInterval
+	}
+	if p.Multiplier <= 0 {
+		p.Multiplier = DefaultRetryPolicy.Multiplier
+	}
+	if p.ShouldRetry == nil {
+		p.ShouldRetry = defaultShouldRetry
+	}
+	return p
+}
+
+// retryTransport wraps an http.RoundTripper, retrying requests that fail
+// transiently until the owning Client's RetryTimeout has elapsed. The
+// timeout and policy are read from the Client on every request so that
+// setting c.RetryTimeout/c.RetryPolicy after construction takes effect
+// immediately.
+type retryTransport struct {
+	next   http.RoundTripper
+	client *Client
+}
+
+func newRetryTransport(next http.RoundTripper, client *Client) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryTransport{next: next, client: client}
+}
+
+// RoundTrip implements http.RoundTripper, retrying the request with
+// exponential backoff and jitter until the retry timeout elapses. It
+// re-buffers the request body via Request.GetBody (falling back to
+// materializing the body once) so POST/PUT/DELETE calls can be retried
+// safely, and it drains and closes the previous response body before every
+// retry. Requests are passed straight through when RetryTimeout <= 0.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.client.RetryTimeout
+	if timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	policy := t.client.RetryPolicy.withDefaults()
+
+	getBody, err := bufferedGetBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	interval := policy.InitialInterval
+
+	for attempt := 1; ; attempt++ {
+		if getBody != nil {
+			if req.Body, err = getBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.next.RoundTrip(req)
+
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+		if !policy.ShouldRetry(resp, err) {
+			return resp, err
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, err)
+		}
+
+		select {
+		case <-time.After(withJitter(interval, policy.Jitter)):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		interval = time.Duration(float64(interval) * policy.Multiplier)
+		if interval > policy.MaxInterval {
+			interval = policy.MaxInterval
+		}
+	}
+}
+
+func withJitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return d
+	}
+	delta := float64(d) * fraction
+	return d - time.Duration(delta) + time.Duration(rand.Float64()*2*delta)
+}
+
+// bufferedGetBody returns a function that yields a fresh copy of req's body
+// on every call, preferring the stdlib-populated GetBody when available and
+// otherwise materializing the body once so it can be replayed.
+func bufferedGetBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+
+	return func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}, nil
+}
@@ -0,0 +1,153 @@
+package teamcity
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestDefaultShouldRetryOnConnectionReset(t *testing.T) {
+	err := &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}
+	if !defaultShouldRetry(nil, err) {
+		t.Fatalf("defaultShouldRetry(nil, %v) = false, want true for a connection reset", err)
+	}
+}
+
+type stubRoundTripper struct {
+	responses []*http.Response
+	bodies    []string
+}
+
+func (s *stubRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	i := len(s.bodies)
+
+	var body string
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(b)
+	}
+	s.bodies = append(s.bodies, body)
+
+	resp := s.responses[i]
+	resp.Body = ioutil.NopCloser(bytes.NewReader(nil))
+	return resp, nil
+}
+
+func testPolicy() RetryPolicy {
+	return RetryPolicy{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond, Multiplier: 2}
+}
+
+func TestRetryTransportRetriesUntilSuccess(t *testing.T) {
+	stub := &stubRoundTripper{responses: []*http.Response{
+		{StatusCode: 500},
+		{StatusCode: 500},
+		{StatusCode: 200},
+	}}
+	client := &Client{RetryTimeout: time.Second, RetryPolicy: testPolicy()}
+	transport := newRetryTransport(stub, client)
+
+	body := `{"hello":"world"}`
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/x", bytes.NewBufferString(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewBufferString(body)), nil
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != 200 {
+		t.Fatalf("RoundTrip() final status = %d, want 200", resp.StatusCode)
+	}
+	if len(stub.bodies) != 3 {
+		t.Fatalf("server saw %d attempts, want 3", len(stub.bodies))
+	}
+	for i, b := range stub.bodies {
+		if b != body {
+			t.Errorf("attempt %d body = %q, want %q (body must be re-buffered on retry)", i, b, body)
+		}
+	}
+}
+
+func TestRetryTransportGivesUpAfterDeadline(t *testing.T) {
+	stub := &stubRoundTripper{responses: []*http.Response{
+		{StatusCode: 500},
+		{StatusCode: 500},
+		{StatusCode: 500},
+	}}
+	client := &Client{RetryTimeout: 5 * time.Millisecond, RetryPolicy: RetryPolicy{
+		InitialInterval: 20 * time.Millisecond,
+		MaxInterval:     20 * time.Millisecond,
+		Multiplier:      2,
+	}}
+	transport := newRetryTransport(stub, client)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/x", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != 500 {
+		t.Fatalf("RoundTrip() status = %d, want 500 (gives up once the next backoff would cross the deadline)", resp.StatusCode)
+	}
+	if len(stub.bodies) != 1 {
+		t.Fatalf("server saw %d attempts, want 1 (initial attempt only, no room left for a retry)", len(stub.bodies))
+	}
+}
+
+func TestRetryTransportStopsOnContextCancellation(t *testing.T) {
+	stub := &stubRoundTripper{responses: []*http.Response{
+		{StatusCode: 500},
+		{StatusCode: 200},
+	}}
+	client := &Client{RetryTimeout: time.Second, RetryPolicy: RetryPolicy{
+		InitialInterval: 50 * time.Millisecond,
+		MaxInterval:     50 * time.Millisecond,
+		Multiplier:      2,
+	}}
+	transport := newRetryTransport(stub, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/x", nil).WithContext(ctx)
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := transport.RoundTrip(req)
+	if err != ctx.Err() {
+		t.Fatalf("RoundTrip() error = %v, want the context's cancellation error", err)
+	}
+	if len(stub.bodies) != 1 {
+		t.Fatalf("server saw %d attempts, want 1 (canceled mid-backoff before a second attempt)", len(stub.bodies))
+	}
+}
+
+func TestRetryTransportPassesThroughWhenRetryDisabled(t *testing.T) {
+	stub := &stubRoundTripper{responses: []*http.Response{{StatusCode: 500}}}
+	client := &Client{}
+	transport := newRetryTransport(stub, client)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/x", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != 500 {
+		t.Fatalf("RoundTrip() status = %d, want 500", resp.StatusCode)
+	}
+	if len(stub.bodies) != 1 {
+		t.Fatalf("server saw %d attempts, want 1 (RetryTimeout <= 0 must be a no-op)", len(stub.bodies))
+	}
+}
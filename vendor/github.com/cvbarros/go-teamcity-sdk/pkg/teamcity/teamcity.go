@@ -1,6 +1,7 @@
 package teamcity
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -41,10 +42,16 @@ func init() {
 type Client struct {
 	userName, password, address string
 	baseURI                     string
+	ctx                         context.Context
+	uaTransport                 *userAgentTransport
 
 	HTTPClient   *http.Client
 	RetryTimeout time.Duration
 
+	// RetryPolicy tunes the backoff behavior used when RetryTimeout > 0. The
+	// zero value falls back to DefaultRetryPolicy.
+	RetryPolicy RetryPolicy
+
 	commonBase *sling.Sling
 
 	Projects   *ProjectService
@@ -73,21 +80,56 @@ func NewWithAddress(userName, password, address string, httpClient *http.Client)
 }
 
 func newClientInstance(userName, password, address string, httpClient *http.Client) (*Client, error) {
-	sharedClient := sling.New().Base(address+"/httpAuth/app/rest/").
-		SetBasicAuth(userName, password).
+	return NewWithAuth(address, BasicAuth{User: userName, Password: password}, httpClient)
+}
+
+// NewWithAuth creates a new client using the given Authenticator, allowing
+// personal access tokens and OAuth2 bearer tokens in addition to basic auth.
+// The authenticator determines the REST API base path (/httpAuth/app/rest/
+// for BasicAuth, /app/rest/ for token-based authenticators) and is applied
+// to every outgoing request via an http.RoundTripper, so token refresh works
+// transparently across retries.
+func NewWithAuth(address string, auth Authenticator, httpClient *http.Client) (*Client, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	httpClient.Transport = newAuthTransport(httpClient.Transport, auth)
+
+	sharedClient := sling.New().Base(address+auth.basePath()).
 		Set("Accept", "application/json")
 
-	return &Client{
-		userName:   userName,
-		password:   password,
+	client := &Client{
 		address:    address,
 		HTTPClient: httpClient,
 		commonBase: sharedClient,
-		Projects:   newProjectService(sharedClient.New(), httpClient),
-		BuildTypes: newBuildTypeService(sharedClient.New(), httpClient),
+		Projects:   newProjectService(sharedClient.New(), httpClient, address),
+		BuildTypes: newBuildTypeService(sharedClient.New(), httpClient, address),
 		Server:     newServerService(sharedClient.New()),
-		VcsRoots:   newVcsRootService(sharedClient.New(), httpClient),
-	}, nil
+		VcsRoots:   newVcsRootService(sharedClient.New(), httpClient, address),
+	}
+
+	if basic, ok := auth.(BasicAuth); ok {
+		client.userName, client.password = basic.User, basic.Password
+	}
+
+	client.wrapTransportForUserAgent()
+	client.wrapTransportForRetries()
+
+	return client, nil
+}
+
+// wrapTransportForRetries installs a retrying RoundTripper around
+// c.HTTPClient's transport. The wrapper is a no-op on requests made while
+// c.RetryTimeout <= 0, so it is safe to install unconditionally and toggle
+// RetryTimeout at any point afterwards.
+func (c *Client) wrapTransportForRetries() {
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{}
+	}
+	c.HTTPClient.Transport = newRetryTransport(c.HTTPClient.Transport, c)
 }
 
 //AgentRequirementService returns a service to manage agent requirements for a build configuration with given id
@@ -116,18 +158,59 @@ func (c *Client) BuildTypeParameterService(id string) *ParameterService {
 
 //DependencyService returns a service to manage snapshot and artifact dependencies for a build configuration with given id
 func (c *Client) DependencyService(id string) *DependencyService {
-	return NewDependencyService(id, c.HTTPClient, c.commonBase.New())
+	service := NewDependencyService(id, c.HTTPClient, c.commonBase.New())
+	service.ctx = c.ctx
+	return service
 }
 
 //TriggerService returns a service to manage build triggers for a build configuration with given id
 func (c *Client) TriggerService(buildTypeID string) *TriggerService {
-	return newTriggerService(buildTypeID, c.HTTPClient, c.commonBase.New())
+	service := newTriggerService(buildTypeID, c.HTTPClient, c.commonBase.New())
+	service.ctx = c.ctx
+	return service
+}
+
+// WithContext returns a shallow copy of c, and of its Projects/BuildTypes/
+// VcsRoots services, whose non-Context methods (GetByID, Create, Delete,
+// ...) default to ctx instead of context.Background(). TriggerService and
+// DependencyService pick up ctx the next time they're constructed from the
+// returned client; the other per-id services do not. This lets
+// Terraform provider callers thread schema.Resource timeouts through the
+// client without rewriting every call site to the explicit "...Context"
+// variant:
+//
+//	client.WithContext(ctx).Projects.GetByID(id)
+func (c *Client) WithContext(ctx context.Context) *Client {
+	shallow := *c
+	shallow.ctx = ctx
+	shallow.Projects = c.Projects.withContext(ctx)
+	shallow.BuildTypes = c.BuildTypes.withContext(ctx)
+	shallow.VcsRoots = c.VcsRoots.withContext(ctx)
+	return &shallow
+}
+
+// context returns the client's default context, falling back to
+// context.Background() when none was set via WithContext.
+func (c *Client) context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
 }
 
 // Validate tests if the client is properly configured and can be used
 func (c *Client) Validate() (bool, error) {
-	response, err := c.commonBase.Get("server").ReceiveSuccess(nil)
+	return c.ValidateContext(c.context())
+}
+
+// ValidateContext is the context-aware variant of Validate.
+func (c *Client) ValidateContext(ctx context.Context) (bool, error) {
+	req, err := c.commonBase.Get("server").Request()
+	if err != nil {
+		return false, err
+	}
 
+	response, err := c.HTTPClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return false, err
 	}
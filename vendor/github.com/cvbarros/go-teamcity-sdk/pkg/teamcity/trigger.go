@@ -0,0 +1,79 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// Trigger represents a build trigger attached to a build configuration.
+type Trigger struct {
+	ID         string            `json:"id,omitempty"`
+	Type       string            `json:"type"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// TriggerService manages build triggers for a single build configuration.
+type TriggerService struct {
+	buildTypeID string
+	base        *sling.Sling
+	httpClient  *http.Client
+	ctx         context.Context
+}
+
+func newTriggerService(buildTypeID string, httpClient *http.Client, base *sling.Sling) *TriggerService {
+	return &TriggerService{
+		buildTypeID: buildTypeID,
+		base:        base.Path("buildTypes/" + LocatorID(buildTypeID) + "/triggers/"),
+		httpClient:  httpClient,
+	}
+}
+
+func (s *TriggerService) context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// List returns all triggers configured for the build configuration.
+func (s *TriggerService) List() ([]Trigger, error) {
+	return s.ListContext(s.context())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *TriggerService) ListContext(ctx context.Context) ([]Trigger, error) {
+	var out struct {
+		Trigger []Trigger `json:"trigger"`
+	}
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(""), &out); err != nil {
+		return nil, err
+	}
+	return out.Trigger, nil
+}
+
+// Add creates a new trigger on the build configuration.
+func (s *TriggerService) Add(trigger *Trigger) (*Trigger, error) {
+	return s.AddContext(s.context(), trigger)
+}
+
+// AddContext is the context-aware variant of Add.
+func (s *TriggerService) AddContext(ctx context.Context, trigger *Trigger) (*Trigger, error) {
+	var out Trigger
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Post("").BodyJSON(trigger), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes the trigger with the given id.
+func (s *TriggerService) Delete(id string) error {
+	return s.DeleteContext(s.context(), id)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *TriggerService) DeleteContext(ctx context.Context, id string) error {
+	_, err := doJSON(ctx, s.httpClient, s.base.New().Delete(id), nil)
+	return err
+}
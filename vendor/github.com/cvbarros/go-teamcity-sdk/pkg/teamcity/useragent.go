@@ -0,0 +1,58 @@
+package teamcity
+
+import "net/http"
+
+// ModuleVersion is the released version of this SDK, reported in the
+// default User-Agent string so TeamCity audit logs can attribute requests
+// back to a specific client build.
+const ModuleVersion = "0.1.0"
+
+const defaultUserAgent = "go-teamcity/" + ModuleVersion + " (+github.com/whenfresh/terraform-provider-teamcity)"
+
+// userAgentTransport sets a stable User-Agent header on every outgoing
+// request, appending any caller-supplied suffix to the default value. It
+// composes cleanly with retryTransport and authTransport, and with the
+// debug logging installed on loghttp.DefaultTransport.
+type userAgentTransport struct {
+	next      http.RoundTripper
+	userAgent string
+}
+
+func newUserAgentTransport(next http.RoundTripper) *userAgentTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &userAgentTransport{next: next, userAgent: defaultUserAgent}
+}
+
+// RoundTrip implements http.RoundTripper. It clones the request before
+// mutating headers, per the http.RoundTripper contract.
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cloned := req.Clone(req.Context())
+	cloned.Header.Set("User-Agent", t.userAgent)
+	return t.next.RoundTrip(cloned)
+}
+
+// wrapTransportForUserAgent wraps c.HTTPClient's current transport in the
+// User-Agent transport and records it on c so SetUserAgent can update it
+// later. NewWithAuth installs the retry transport around it afterwards, so
+// every retried attempt passes through it again.
+func (c *Client) wrapTransportForUserAgent() {
+	uat := newUserAgentTransport(c.HTTPClient.Transport)
+	c.HTTPClient.Transport = uat
+	c.uaTransport = uat
+}
+
+// SetUserAgent appends suffix to the client's default User-Agent string, so
+// e.g. the Terraform provider can make its own requests attributable:
+//
+//	client.SetUserAgent("terraform-provider-teamcity/" + providerVersion)
+func (c *Client) SetUserAgent(suffix string) {
+	if c.uaTransport == nil {
+		return
+	}
+	c.uaTransport.userAgent = defaultUserAgent
+	if suffix != "" {
+		c.uaTransport.userAgent += " " + suffix
+	}
+}
@@ -0,0 +1,162 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+)
+
+// VcsRoot represents a TeamCity VCS root.
+type VcsRoot struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	VcsName string `json:"vcsName,omitempty"`
+}
+
+// VcsRootPage is one page of a VcsRoot listing, along with the link needed
+// to fetch the next one.
+type VcsRootPage struct {
+	Items []VcsRoot
+
+	nextHref string
+	service  *VcsRootService
+}
+
+// HasNext reports whether a further page is available.
+func (p *VcsRootPage) HasNext() bool {
+	return p.nextHref != ""
+}
+
+// Next fetches the next page. It must not be called when HasNext is false.
+func (p *VcsRootPage) Next() (*VcsRootPage, error) {
+	return p.NextContext(p.service.context())
+}
+
+// NextContext is the context-aware variant of Next.
+func (p *VcsRootPage) NextContext(ctx context.Context) (*VcsRootPage, error) {
+	var out struct {
+		VcsRoot  []VcsRoot `json:"vcs-root"`
+		NextHref string    `json:"nextHref"`
+	}
+	if err := decodeHref(ctx, p.service.httpClient, p.service.address, p.nextHref, &out); err != nil {
+		return nil, err
+	}
+	return &VcsRootPage{Items: out.VcsRoot, nextHref: out.NextHref, service: p.service}, nil
+}
+
+// VcsRootService manages TeamCity VCS roots.
+type VcsRootService struct {
+	base       *sling.Sling
+	httpClient *http.Client
+	address    string
+	ctx        context.Context
+}
+
+func newVcsRootService(base *sling.Sling, httpClient *http.Client, address string) *VcsRootService {
+	return &VcsRootService{base: base.Path("vcs-roots/"), httpClient: httpClient, address: address}
+}
+
+// withContext returns a shallow copy of s whose non-Context methods default
+// to ctx instead of context.Background(). Client.WithContext uses this to
+// propagate its context down to c.VcsRoots.
+func (s *VcsRootService) withContext(ctx context.Context) *VcsRootService {
+	clone := *s
+	clone.ctx = ctx
+	return &clone
+}
+
+func (s *VcsRootService) context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// GetByID returns the VCS root with the given id.
+func (s *VcsRootService) GetByID(id string) (*VcsRoot, error) {
+	return s.GetByIDContext(s.context(), id)
+}
+
+// GetByIDContext is the context-aware variant of GetByID.
+func (s *VcsRootService) GetByIDContext(ctx context.Context, id string) (*VcsRoot, error) {
+	var out VcsRoot
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(LocatorID(id)), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// List returns every VCS root matching locator (nil matches all). For large
+// installations, prefer ListPage to avoid buffering the entire result set in
+// memory.
+func (s *VcsRootService) List(locator *Locator) ([]VcsRoot, error) {
+	return s.ListContext(s.context(), locator)
+}
+
+// ListContext is the context-aware variant of List.
+func (s *VcsRootService) ListContext(ctx context.Context, locator *Locator) ([]VcsRoot, error) {
+	page, err := s.ListPageContext(ctx, locator)
+	if err != nil {
+		return nil, err
+	}
+
+	items := page.Items
+	for page.HasNext() {
+		if page, err = page.NextContext(ctx); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+	}
+	return items, nil
+}
+
+// ListPage returns the first page of VCS roots matching locator (nil matches
+// all), following TeamCity's nextHref link on subsequent calls to
+// VcsRootPage.Next so large installations don't need to be buffered in memory.
+func (s *VcsRootService) ListPage(locator *Locator) (*VcsRootPage, error) {
+	return s.ListPageContext(s.context(), locator)
+}
+
+// ListPageContext is the context-aware variant of ListPage.
+func (s *VcsRootService) ListPageContext(ctx context.Context, locator *Locator) (*VcsRootPage, error) {
+	path := ""
+	if locator.String() != "" {
+		path = "?locator=" + url.QueryEscape(locator.String())
+	}
+
+	var out struct {
+		VcsRoot  []VcsRoot `json:"vcs-root"`
+		NextHref string    `json:"nextHref"`
+	}
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Get(path), &out); err != nil {
+		return nil, err
+	}
+	return &VcsRootPage{Items: out.VcsRoot, nextHref: out.NextHref, service: s}, nil
+}
+
+// Create creates a new VCS root from the given definition.
+func (s *VcsRootService) Create(vcsRoot *VcsRoot) (*VcsRoot, error) {
+	return s.CreateContext(s.context(), vcsRoot)
+}
+
+// CreateContext is the context-aware variant of Create.
+func (s *VcsRootService) CreateContext(ctx context.Context, vcsRoot *VcsRoot) (*VcsRoot, error) {
+	var out VcsRoot
+	if _, err := doJSON(ctx, s.httpClient, s.base.New().Post("").BodyJSON(vcsRoot), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes the VCS root with the given id.
+func (s *VcsRootService) Delete(id string) error {
+	return s.DeleteContext(s.context(), id)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *VcsRootService) DeleteContext(ctx context.Context, id string) error {
+	_, err := doJSON(ctx, s.httpClient, s.base.New().Delete(LocatorID(id)), nil)
+	return err
+}